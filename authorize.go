@@ -0,0 +1,88 @@
+package postgrest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Authorizer computes a row-level authorization filter for a given request,
+// identified by its HTTP method (action) and table, which Client merges
+// into every FilterBuilder it executes. Prepare may return the DenyAll or
+// AllowAll sentinel expressions to short-circuit or skip the filter
+// entirely for that request.
+type Authorizer interface {
+	Prepare(ctx context.Context, action, table string) (Expr, error)
+}
+
+type sentinelExpr struct{ name string }
+
+func (sentinelExpr) render() string             { return "" }
+func (sentinelExpr) topLevel() (string, string) { return "", "" }
+
+// DenyAll is a sentinel Expr an Authorizer can return from Prepare to
+// short-circuit the request to an empty result without hitting the server.
+var DenyAll Expr = sentinelExpr{name: "deny-all"}
+
+// AllowAll is a sentinel Expr an Authorizer can return from Prepare to add
+// no filter at all, allowing the request to proceed unrestricted.
+var AllowAll Expr = sentinelExpr{name: "allow-all"}
+
+func isDenyAll(expr Expr) bool {
+	s, ok := expr.(sentinelExpr)
+	return ok && s.name == "deny-all"
+}
+
+func isAllowAll(expr Expr) bool {
+	s, ok := expr.(sentinelExpr)
+	return ok && s.name == "allow-all"
+}
+
+// WithAuthorizer configures c so that every FilterBuilder it subsequently
+// creates has a's row-level filter AND-merged into its params before
+// Execute, ExecuteTo and ExecuteString send the request, so callers can't
+// forget to apply it.
+func (c *Client) WithAuthorizer(a Authorizer) *Client {
+	c.authorizer = a
+	return c
+}
+
+// applyAuthorization asks f's client's Authorizer (if any) for a filter
+// scoped to this request and merges it into f.params. It reports denied=true
+// when the Authorizer returned DenyAll, in which case the caller should
+// short-circuit to an empty result instead of hitting the server.
+func (f *FilterBuilder) applyAuthorization() (denied bool, err error) {
+	if f.client.authorizer == nil {
+		return false, nil
+	}
+
+	authExpr, err := f.client.authorizer.Prepare(context.Background(), f.method, f.tableName)
+	if err != nil {
+		return false, err
+	}
+	if authExpr == nil || isAllowAll(authExpr) {
+		return false, nil
+	}
+	if isDenyAll(authExpr) {
+		return true, nil
+	}
+
+	key, value := authExpr.topLevel()
+	if _, clash := f.params[key]; !clash {
+		f.params[key] = value
+		return false, nil
+	}
+
+	// key already holds the request's own filter, so the authorization
+	// filter joins the top-level and-group instead of replacing that filter.
+	member := authExpr.render()
+	if key == "and" {
+		member = strings.TrimSuffix(strings.TrimPrefix(value, "("), ")")
+	}
+	if group, ok := f.params["and"]; ok {
+		f.params["and"] = fmt.Sprintf("(%s,%s)", strings.TrimSuffix(strings.TrimPrefix(group, "("), ")"), member)
+	} else {
+		f.params["and"] = fmt.Sprintf("(%s)", member)
+	}
+	return false, nil
+}
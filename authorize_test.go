@@ -0,0 +1,86 @@
+package postgrest
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type stubAuthorizer struct {
+	expr Expr
+	err  error
+}
+
+func (s stubAuthorizer) Prepare(ctx context.Context, action, table string) (Expr, error) {
+	return s.expr, s.err
+}
+
+func TestApplyAuthorizationMergesIntoExistingAnd(t *testing.T) {
+	f := &FilterBuilder{
+		client: &Client{authorizer: stubAuthorizer{expr: And(Eq("tenant_id", "1"))}},
+		params: map[string]string{"and": "(age.gte.18)"},
+	}
+
+	denied, err := f.applyAuthorization()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if denied {
+		t.Fatalf("expected not denied")
+	}
+	want := "(age.gte.18,tenant_id.eq.1)"
+	if got := f.params["and"]; got != want {
+		t.Errorf("params[and] = %q, want %q", got, want)
+	}
+}
+
+func TestApplyAuthorizationDenyAllShortCircuits(t *testing.T) {
+	f := &FilterBuilder{
+		client: &Client{authorizer: stubAuthorizer{expr: DenyAll}},
+		params: map[string]string{},
+	}
+	denied, err := f.applyAuthorization()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !denied {
+		t.Errorf("expected denied")
+	}
+}
+
+func TestApplyAuthorizationAllowAllSkipsFilter(t *testing.T) {
+	f := &FilterBuilder{
+		client: &Client{authorizer: stubAuthorizer{expr: AllowAll}},
+		params: map[string]string{},
+	}
+	denied, err := f.applyAuthorization()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if denied {
+		t.Errorf("expected not denied")
+	}
+	if len(f.params) != 0 {
+		t.Errorf("params = %v, want untouched", f.params)
+	}
+}
+
+func TestApplyAuthorizationPropagatesPrepareError(t *testing.T) {
+	wantErr := errors.New("boom")
+	f := &FilterBuilder{
+		client: &Client{authorizer: stubAuthorizer{err: wantErr}},
+		params: map[string]string{},
+	}
+	_, err := f.applyAuthorization()
+	if !errors.Is(err, wantErr) {
+		t.Errorf("err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestApplyAuthorizationNoAuthorizerIsNoop(t *testing.T) {
+	f := &FilterBuilder{client: &Client{}, params: map[string]string{}}
+	denied, err := f.applyAuthorization()
+	if err != nil || denied {
+		t.Errorf("denied, err = %v, %v, want false, nil", denied, err)
+	}
+}
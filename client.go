@@ -0,0 +1,150 @@
+package postgrest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Client is the base PostgREST client: it holds the connection details
+// (base URL, default headers) shared by every QueryBuilder/FilterBuilder
+// built from it, plus the most recent error recorded while building a
+// request so callers can check it once at the end of a chain instead of
+// after every call.
+type Client struct {
+	ClientError error
+
+	session    *http.Client
+	baseURL    string
+	headers    map[string]string
+	authorizer Authorizer
+}
+
+// NewClient builds a Client targeting rawURL, sending headers on every
+// request it makes.
+func NewClient(rawURL string, headers map[string]string) *Client {
+	return &Client{
+		session: &http.Client{},
+		baseURL: strings.TrimRight(rawURL, "/"),
+		headers: headers,
+	}
+}
+
+// From starts a query against tableName.
+func (c *Client) From(tableName string) *QueryBuilder {
+	headers := make(map[string]string, len(c.headers))
+	for k, v := range c.headers {
+		headers[k] = v
+	}
+	return &QueryBuilder{
+		client:    c,
+		tableName: tableName,
+		headers:   headers,
+		params:    map[string]string{},
+	}
+}
+
+// QueryBuilder configures the request (columns, count) before it narrows
+// into a FilterBuilder to add filters and execute.
+type QueryBuilder struct {
+	client    *Client
+	body      []byte
+	tableName string
+	headers   map[string]string
+	params    map[string]string
+}
+
+// Select chooses the columns (and embedded resources) to return, optionally
+// requesting a row count and a HEAD-only request.
+func (q *QueryBuilder) Select(columns, count string, head bool) *FilterBuilder {
+	if columns != "" {
+		q.params["select"] = columns
+	}
+	method := http.MethodGet
+	if head {
+		method = http.MethodHead
+	}
+	if count != "" {
+		q.headers["Prefer"] = "count=" + count
+	}
+	return &FilterBuilder{
+		client:    q.client,
+		method:    method,
+		body:      q.body,
+		tableName: q.tableName,
+		headers:   q.headers,
+		params:    q.params,
+	}
+}
+
+func buildRequest(ctx context.Context, client *Client, method string, body []byte, tableNames []string, headers map[string]string, params map[string]string) (*http.Request, error) {
+	u, err := url.Parse(client.baseURL + "/" + strings.Join(tableNames, "/"))
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	for k, v := range params {
+		q.Set(k, v)
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return req, nil
+}
+
+// executeRange is the context-aware, header-returning counterpart to
+// execute/executeString/executeTo used by RowIterator to page through
+// results and inspect the Content-Range response header.
+func executeRange(ctx context.Context, client *Client, method string, body []byte, tableNames []string, headers map[string]string, params map[string]string) ([]byte, http.Header, error) {
+	req, err := buildRequest(ctx, client, method, body, tableNames, headers, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := client.session.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, nil, fmt.Errorf("postgrest: %s", string(respBody))
+	}
+	return respBody, resp.Header, nil
+}
+
+func execute(client *Client, method string, body []byte, tableNames []string, headers map[string]string, params map[string]string) ([]byte, error) {
+	respBody, _, err := executeRange(context.Background(), client, method, body, tableNames, headers, params)
+	return respBody, err
+}
+
+func executeString(client *Client, method string, body []byte, tableNames []string, headers map[string]string, params map[string]string) (string, error) {
+	respBody, err := execute(client, method, body, tableNames, headers, params)
+	if err != nil {
+		return "", err
+	}
+	return string(respBody), nil
+}
+
+func executeTo(client *Client, method string, body []byte, to interface{}, tableNames []string, headers map[string]string, params map[string]string) error {
+	respBody, err := execute(client, method, body, tableNames, headers, params)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(respBody, to)
+}
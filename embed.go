@@ -0,0 +1,135 @@
+package postgrest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EmbeddedBuilder builds the filter/order/limit/select params for one
+// embedded (joined) resource inside a FilterBuilder.Embed call, writing
+// them with the resource. prefix PostgREST expects (e.g. "author.age").
+// Embed itself lets the resource nest inside the parent's select list
+// (recursively, for nested embeds like author.books.tags).
+type EmbeddedBuilder struct {
+	f          *FilterBuilder
+	resource   string
+	prefix     string
+	selectCols string
+	children   []string
+}
+
+// Embed adds an embedded (joined) resource to the select list, letting fn
+// filter, order, limit and select columns on it via an EmbeddedBuilder -
+// the resource-scoped equivalent of the top-level FilterBuilder surface.
+// Nested embeds (author.books.tags) are built by calling Embed again on the
+// EmbeddedBuilder passed to fn.
+func (f *FilterBuilder) Embed(resource string, fn func(*EmbeddedBuilder)) *FilterBuilder {
+	eb := &EmbeddedBuilder{f: f, resource: resource, prefix: resource + "."}
+	fn(eb)
+
+	fragment := eb.render()
+	if existing, ok := f.params["select"]; ok && existing != "" {
+		f.params["select"] = existing + "," + fragment
+	} else {
+		f.params["select"] = fragment
+	}
+	return f
+}
+
+func (e *EmbeddedBuilder) render() string {
+	hint := ""
+	if e.f.embedHints != nil {
+		hint = e.f.embedHints[e.resource]
+	}
+	cols := e.selectCols
+	if cols == "" {
+		cols = "*"
+	}
+	parts := append([]string{cols}, e.children...)
+	return fmt.Sprintf("%s%s(%s)", e.resource, hint, strings.Join(parts, ","))
+}
+
+// Embed nests a further embedded resource under this one, e.g. calling
+// Embed("tags", ...) inside an author.books Embed builds author.books.tags.
+func (e *EmbeddedBuilder) Embed(resource string, fn func(*EmbeddedBuilder)) *EmbeddedBuilder {
+	child := &EmbeddedBuilder{f: e.f, resource: resource, prefix: e.prefix + resource + "."}
+	fn(child)
+	e.children = append(e.children, child.render())
+	return e
+}
+
+// Select sets the columns returned for this embedded resource, equivalent
+// to the column list inside PostgREST's "resource(columns)" select syntax.
+func (e *EmbeddedBuilder) Select(columns string) *EmbeddedBuilder {
+	e.selectCols = columns
+	return e
+}
+
+// Eq, Neq, Gt, In and TextSearch below are the resource-scoped equivalents
+// of FilterBuilder's own filter methods, writing into the same params map
+// under the resource. prefix instead of the bare column name.
+
+func (e *EmbeddedBuilder) Eq(column, value string) *EmbeddedBuilder {
+	e.f.params[e.prefix+column] = "eq." + value
+	return e
+}
+
+func (e *EmbeddedBuilder) Neq(column, value string) *EmbeddedBuilder {
+	e.f.params[e.prefix+column] = "neq." + value
+	return e
+}
+
+func (e *EmbeddedBuilder) Gt(column, value string) *EmbeddedBuilder {
+	e.f.params[e.prefix+column] = "gt." + value
+	return e
+}
+
+func (e *EmbeddedBuilder) In(column string, values []string) *EmbeddedBuilder {
+	e.f.params[e.prefix+column] = fmt.Sprintf("in.(%s)", strings.Join(escapeFilterValues(values), ","))
+	return e
+}
+
+func (e *EmbeddedBuilder) TextSearch(column, userQuery, config, tsType string) *EmbeddedBuilder {
+	var typePart, configPart string
+	switch tsType {
+	case "plain":
+		typePart = "pl"
+	case "phrase":
+		typePart = "ph"
+	case "websearch":
+		typePart = "w"
+	case "":
+		typePart = ""
+	default:
+		e.f.client.ClientError = fmt.Errorf("invalid text search type")
+		return e
+	}
+	if config != "" {
+		configPart = fmt.Sprintf("(%s)", config)
+	}
+	e.f.params[e.prefix+column] = typePart + "fts" + configPart + "." + userQuery
+	return e
+}
+
+// Order renders e.g. "author.order=name.asc".
+func (e *EmbeddedBuilder) Order(column string, ascending bool) *EmbeddedBuilder {
+	direction := "asc"
+	if !ascending {
+		direction = "desc"
+	}
+	e.f.params[e.prefix+"order"] = column + "." + direction
+	return e
+}
+
+// Limit renders e.g. "books.limit=5".
+func (e *EmbeddedBuilder) Limit(count int) *EmbeddedBuilder {
+	e.f.params[e.prefix+"limit"] = strconv.Itoa(count)
+	return e
+}
+
+// Offset renders e.g. "books.offset=5".
+func (e *EmbeddedBuilder) Offset(count int) *EmbeddedBuilder {
+	e.f.params[e.prefix+"offset"] = strconv.Itoa(count)
+	return e
+}
@@ -0,0 +1,23 @@
+package postgrest
+
+import "testing"
+
+func TestEmbedRendersNestedSelect(t *testing.T) {
+	f := &FilterBuilder{client: &Client{}, params: map[string]string{}}
+	f.InnerJoin("author")
+	f.Embed("author", func(e *EmbeddedBuilder) {
+		e.Select("name")
+		e.Gt("age", "18")
+		e.Embed("books", func(b *EmbeddedBuilder) {
+			b.Select("title")
+		})
+	})
+
+	want := "author!inner(name,books(title))"
+	if got := f.params["select"]; got != want {
+		t.Errorf("params[select] = %q, want %q", got, want)
+	}
+	if got := f.params["author.age"]; got != "gt.18" {
+		t.Errorf("params[author.age] = %q, want %q", got, "gt.18")
+	}
+}
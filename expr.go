@@ -0,0 +1,194 @@
+package postgrest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Expr is a node in a PostgREST logical filter expression tree. Leaf nodes
+// represent a single column comparison (e.g. Eq("age", "30")); And/Or/Not
+// combine other Exprs using PostgREST's logical operator syntax
+// (and=(...), or=(...,and(...,...)), not.eq.foo, ...).
+type Expr interface {
+	// render renders the expr the way it appears nested inside a parent
+	// and()/or() group, e.g. "age.gte.18" or "and(a.eq.1,b.eq.2)".
+	render() string
+	// topLevel renders the expr as a standalone query param, returning the
+	// param key and value separately, e.g. ("age", "gte.18") or
+	// ("and", "(a.eq.1,b.eq.2)").
+	topLevel() (string, string)
+}
+
+type filterExpr struct {
+	column   string
+	operator string
+	value    string
+	negate   bool
+}
+
+func newLeaf(column, operator, value string) *filterExpr {
+	return &filterExpr{column: column, operator: operator, value: escapeFilterValue(value)}
+}
+
+func (e *filterExpr) opPart() string {
+	if e.negate {
+		return "not." + e.operator + "." + e.value
+	}
+	return e.operator + "." + e.value
+}
+
+func (e *filterExpr) render() string {
+	return e.column + "." + e.opPart()
+}
+
+func (e *filterExpr) topLevel() (string, string) {
+	return e.column, e.opPart()
+}
+
+type logicalExpr struct {
+	op       string // "and" or "or"
+	children []Expr
+}
+
+func (e *logicalExpr) render() string {
+	parts := make([]string, len(e.children))
+	for i, child := range e.children {
+		parts[i] = child.render()
+	}
+	return fmt.Sprintf("%s(%s)", e.op, strings.Join(parts, ","))
+}
+
+func (e *logicalExpr) topLevel() (string, string) {
+	parts := make([]string, len(e.children))
+	for i, child := range e.children {
+		parts[i] = child.render()
+	}
+	return e.op, fmt.Sprintf("(%s)", strings.Join(parts, ","))
+}
+
+type notExpr struct {
+	inner Expr
+}
+
+func (e *notExpr) render() string {
+	return "not." + e.inner.render()
+}
+
+func (e *notExpr) topLevel() (string, string) {
+	key, value := e.inner.topLevel()
+	return "not." + key, value
+}
+
+// escapeFilterValue quotes a filter value if it contains characters that
+// would otherwise be ambiguous with PostgREST's filter syntax, the same way
+// FilterBuilder.In does for its value slice.
+func escapeFilterValue(value string) string {
+	if illegalChars.MatchString(value) {
+		return fmt.Sprintf("\"%s\"", value)
+	}
+	return value
+}
+
+func escapeFilterValues(values []string) []string {
+	cleaned := make([]string, len(values))
+	for i, value := range values {
+		cleaned[i] = escapeFilterValue(value)
+	}
+	return cleaned
+}
+
+// And combines the given expressions with PostgREST's and() logical
+// operator, e.g. And(Eq("age", "30"), Gt("score", "10")) renders as
+// "and(age.eq.30,score.gt.10)".
+func And(exprs ...Expr) Expr {
+	return &logicalExpr{op: "and", children: exprs}
+}
+
+// Or combines the given expressions with PostgREST's or() logical operator,
+// e.g. Or(Like("name", "*j*"), Gt("score", "10")) renders as
+// "or(name.like.*j*,score.gt.10)".
+func Or(exprs ...Expr) Expr {
+	return &logicalExpr{op: "or", children: exprs}
+}
+
+// Not negates the given expression. Negating a leaf inserts PostgREST's
+// not. prefix immediately before the operator (e.g. "age.not.eq.30");
+// negating a group prefixes the group itself (e.g. "not.and(...)").
+func Not(expr Expr) Expr {
+	if leaf, ok := expr.(*filterExpr); ok {
+		negated := *leaf
+		negated.negate = !negated.negate
+		return &negated
+	}
+	return &notExpr{inner: expr}
+}
+
+// Leaf constructors below mirror FilterBuilder's own filter methods
+// (Eq, Neq, Gt, ...) for use inside And/Or/Not trees.
+
+func Eq(column, value string) Expr    { return newLeaf(column, "eq", value) }
+func Neq(column, value string) Expr   { return newLeaf(column, "neq", value) }
+func Gt(column, value string) Expr    { return newLeaf(column, "gt", value) }
+func Gte(column, value string) Expr   { return newLeaf(column, "gte", value) }
+func Lt(column, value string) Expr    { return newLeaf(column, "lt", value) }
+func Lte(column, value string) Expr   { return newLeaf(column, "lte", value) }
+func Like(column, value string) Expr  { return newLeaf(column, "like", value) }
+func Ilike(column, value string) Expr { return newLeaf(column, "ilike", value) }
+func Is(column, value string) Expr    { return newLeaf(column, "is", value) }
+
+// In builds the same in.(...) leaf as FilterBuilder.In. The joined,
+// already-escaped list is built directly rather than through newLeaf, which
+// would otherwise re-escape the parentheses and commas it just produced.
+func In(column string, values []string) Expr {
+	return &filterExpr{column: column, operator: "in", value: fmt.Sprintf("(%s)", strings.Join(escapeFilterValues(values), ","))}
+}
+
+// Contains, ContainedBy and Overlaps build their list value directly rather
+// than through newLeaf, for the same reason as In: the list is already
+// escaped and joined, so routing it through newLeaf would re-escape it.
+
+func Contains(column string, values []string) Expr {
+	return &filterExpr{column: column, operator: "cs", value: strings.Join(escapeFilterValues(values), ",")}
+}
+
+func ContainedBy(column string, values []string) Expr {
+	return &filterExpr{column: column, operator: "cd", value: strings.Join(escapeFilterValues(values), ",")}
+}
+
+func RangeLt(column, value string) Expr       { return newLeaf(column, "sl", value) }
+func RangeGt(column, value string) Expr       { return newLeaf(column, "sr", value) }
+func RangeGte(column, value string) Expr      { return newLeaf(column, "nxl", value) }
+func RangeLte(column, value string) Expr      { return newLeaf(column, "nxr", value) }
+func RangeAdjacent(column, value string) Expr { return newLeaf(column, "adj", value) }
+
+func Overlaps(column string, values []string) Expr {
+	return &filterExpr{column: column, operator: "ov", value: strings.Join(escapeFilterValues(values), ",")}
+}
+
+// Between builds an and() group expression that matches rows where column is
+// between lower and upper (inclusive), rendered as "and(col.gte.lower,col.lte.upper)".
+func Between(column, lower, upper string) Expr {
+	return And(Gte(column, lower), Lte(column, upper))
+}
+
+// TextSearch builds a leaf expression equivalent to FilterBuilder.TextSearch.
+// tsType is one of "plain", "phrase", "websearch" or "" for a plain fts.
+func TextSearch(column, userQuery, config, tsType string) (Expr, error) {
+	var typePart, configPart string
+	switch tsType {
+	case "plain":
+		typePart = "pl"
+	case "phrase":
+		typePart = "ph"
+	case "websearch":
+		typePart = "w"
+	case "":
+		typePart = ""
+	default:
+		return nil, fmt.Errorf("invalid text search type")
+	}
+	if config != "" {
+		configPart = fmt.Sprintf("(%s)", config)
+	}
+	return newLeaf(column, typePart+"fts"+configPart, userQuery), nil
+}
@@ -0,0 +1,67 @@
+package postgrest
+
+import "testing"
+
+func TestInRender(t *testing.T) {
+	cases := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{"simple", []string{"1", "2", "3"}, "in.(1,2,3)"},
+		{"needs quoting", []string{"a,b", "c"}, `in.("a,b",c)`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			key, value := In("id", c.values).topLevel()
+			if key != "id" {
+				t.Errorf("key = %q, want %q", key, "id")
+			}
+			if value != c.want {
+				t.Errorf("value = %q, want %q", value, c.want)
+			}
+		})
+	}
+}
+
+func TestContainsContainedByOverlapsEscapeOnce(t *testing.T) {
+	cases := []struct {
+		name string
+		expr Expr
+		want string
+	}{
+		{"Contains", Contains("tags", []string{"a,b", "c"}), `cs."a,b",c`},
+		{"ContainedBy", ContainedBy("tags", []string{"a,b", "c"}), `cd."a,b",c`},
+		{"Overlaps", Overlaps("tags", []string{"a,b", "c"}), `ov."a,b",c`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			key, value := c.expr.topLevel()
+			if key != "tags" {
+				t.Errorf("key = %q, want %q", key, "tags")
+			}
+			if value != c.want {
+				t.Errorf("value = %q, want %q", value, c.want)
+			}
+		})
+	}
+}
+
+func TestAndOrNotRender(t *testing.T) {
+	expr := And(Eq("age", "30"), Or(Like("name", "*j*"), Gt("score", "10")))
+	key, value := expr.topLevel()
+	if key != "and" {
+		t.Errorf("key = %q, want %q", key, "and")
+	}
+	want := "(age.eq.30,or(name.like.*j*,score.gt.10))"
+	if value != want {
+		t.Errorf("value = %q, want %q", value, want)
+	}
+
+	notKey, notValue := Not(Eq("age", "30")).topLevel()
+	if notKey != "age" || notValue != "not.eq.30" {
+		t.Errorf("Not(Eq) = (%q, %q), want (%q, %q)", notKey, notValue, "age", "not.eq.30")
+	}
+}
@@ -8,28 +8,52 @@ import (
 )
 
 type FilterBuilder struct {
-	client    *Client
-	method    string
-	body      []byte
-	tableName string
-	headers   map[string]string
-	params    map[string]string
+	client     *Client
+	method     string
+	body       []byte
+	tableName  string
+	headers    map[string]string
+	params     map[string]string
+	embedHints map[string]string
 }
 
 func (f *FilterBuilder) ExecuteString() (string, error) {
+	denied, err := f.applyAuthorization()
+	if err != nil {
+		return "", err
+	}
+	if denied {
+		return "[]", nil
+	}
 	return executeString(f.client, f.method, f.body, []string{f.tableName}, f.headers, f.params)
 }
 
 func (f *FilterBuilder) Execute() ([]byte, error) {
+	denied, err := f.applyAuthorization()
+	if err != nil {
+		return nil, err
+	}
+	if denied {
+		return []byte("[]"), nil
+	}
 	return execute(f.client, f.method, f.body, []string{f.tableName}, f.headers, f.params)
 }
 
 func (f *FilterBuilder) ExecuteTo(to interface{}) error {
+	denied, err := f.applyAuthorization()
+	if err != nil {
+		return err
+	}
+	if denied {
+		return json.Unmarshal([]byte("[]"), to)
+	}
 	return executeTo(f.client, f.method, f.body, to, []string{f.tableName}, f.headers, f.params)
 }
 
 var filterOperators = []string{"eq", "neq", "gt", "gte", "lt", "lte", "like", "ilike", "is", "in", "cs", "cd", "sl", "sr", "nxl", "nxr", "adj", "ov", "fts", "plfts", "phfts", "wfts"}
 
+var illegalChars = regexp.MustCompile("[,()]")
+
 func isOperator(value string) bool {
 	for _, operator := range filterOperators {
 		if value == operator {
@@ -57,6 +81,50 @@ func (f *FilterBuilder) Or(filters, foreignTable string) *FilterBuilder {
 	return f
 }
 
+// Where adds an arbitrarily nested logical filter expression built from And,
+// Or, Not and the leaf constructors (Eq, Like, Gt, ...), e.g.:
+//
+//	f.Where(postgrest.And(postgrest.Eq("age", "30"), postgrest.Or(postgrest.Like("name", "*j*"), postgrest.Gt("score", "10"))))
+func (f *FilterBuilder) Where(expr Expr) *FilterBuilder {
+	key, value := expr.topLevel()
+	f.params[key] = value
+	return f
+}
+
+// OrExpr is like Where but scopes the expression to an embedded resource,
+// mirroring the foreignTable prefixing Or already does for raw filter
+// strings, e.g. f.OrExpr(postgrest.Or(postgrest.Eq("id", "1")), "author").
+func (f *FilterBuilder) OrExpr(expr Expr, foreignTable string) *FilterBuilder {
+	key, value := expr.topLevel()
+	if foreignTable != "" {
+		key = foreignTable + "." + key
+	}
+	f.params[key] = value
+	return f
+}
+
+// InnerJoin marks resource so that the next Embed of it renders PostgREST's
+// !inner hint (e.g. "author!inner(...)"), turning the embed into an inner
+// join that excludes rows with no matching embedded resource.
+func (f *FilterBuilder) InnerJoin(resource string) *FilterBuilder {
+	if f.embedHints == nil {
+		f.embedHints = map[string]string{}
+	}
+	f.embedHints[resource] = "!inner"
+	return f
+}
+
+// Spread marks resource so that the next Embed of it renders PostgREST's
+// !... spread hint (e.g. "author!...(...)"), flattening the embedded
+// resource's columns into the parent row instead of nesting them.
+func (f *FilterBuilder) Spread(resource string) *FilterBuilder {
+	if f.embedHints == nil {
+		f.embedHints = map[string]string{}
+	}
+	f.embedHints[resource] = "!..."
+	return f
+}
+
 func (f *FilterBuilder) Not(column, operator, value string) *FilterBuilder {
 	if !isOperator(operator) {
 		return f
@@ -119,7 +187,6 @@ func (f *FilterBuilder) Is(column, value string) *FilterBuilder {
 
 func (f *FilterBuilder) In(column string, values []string) *FilterBuilder {
 	var cleanedValues []string
-	illegalChars := regexp.MustCompile("[,()]")
 	for _, value := range values {
 		exp := illegalChars.MatchString(value)
 		if exp {
@@ -156,7 +223,7 @@ func (f *FilterBuilder) ContainedByObject(column string, value interface{}) *Fil
 	if err != nil {
 		f.client.ClientError = err
 	}
-	f.params[column] = "cs." + string(sum)
+	f.params[column] = "cd." + string(sum)
 	return f
 }
 
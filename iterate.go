@@ -0,0 +1,155 @@
+package postgrest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RowIterator streams the rows matched by a FilterBuilder one at a time,
+// fetching successive pages with PostgREST's Range/Range-Unit headers
+// instead of buffering the whole result set the way Execute/ExecuteTo do.
+// Obtain one via Iterate and release it with Close.
+type RowIterator[T any] struct {
+	f        *FilterBuilder
+	ctx      context.Context
+	cancel   context.CancelFunc
+	pageSize int
+	offset   int
+	total    int // -1 until the Content-Range header reports a known total
+	pending  []json.RawMessage
+	cur      T
+	err      error
+	done     bool
+}
+
+// Iterate returns a RowIterator over the rows matched by f, fetching
+// pageSize rows at a time. The first page is fetched eagerly so that a
+// request error surfaces from Iterate rather than from the first Next call.
+//
+// Go methods cannot take their own type parameters, so Iterate is a
+// package-level function rather than a method on FilterBuilder:
+//
+//	it, err := postgrest.Iterate[Book](f, ctx, 100)
+func Iterate[T any](f *FilterBuilder, ctx context.Context, pageSize int) (*RowIterator[T], error) {
+	if pageSize <= 0 {
+		return nil, fmt.Errorf("postgrest: pageSize must be positive")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	it := &RowIterator[T]{f: f, ctx: ctx, cancel: cancel, pageSize: pageSize, total: -1}
+	if err := it.fetchNextPage(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return it, nil
+}
+
+func (it *RowIterator[T]) fetchNextPage() error {
+	if it.offset == 0 {
+		denied, err := it.f.applyAuthorization()
+		if err != nil {
+			return err
+		}
+		if denied {
+			it.done = true
+			return nil
+		}
+	}
+
+	headers := make(map[string]string, len(it.f.headers)+2)
+	for k, v := range it.f.headers {
+		headers[k] = v
+	}
+	headers["Range-Unit"] = "items"
+	headers["Range"] = fmt.Sprintf("%d-%d", it.offset, it.offset+it.pageSize-1)
+
+	body, respHeaders, err := executeRange(it.ctx, it.f.client, it.f.method, it.f.body, []string{it.f.tableName}, headers, it.f.params)
+	if err != nil {
+		return err
+	}
+
+	var page []json.RawMessage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return err
+	}
+	it.pending = append(it.pending, page...)
+	it.offset += len(page)
+
+	if total, ok := parseContentRangeTotal(respHeaders.Get("Content-Range")); ok {
+		it.total = total
+	}
+	if len(page) < it.pageSize || (it.total >= 0 && it.offset >= it.total) {
+		it.done = true
+	}
+	return nil
+}
+
+// parseContentRangeTotal extracts the total row count from a PostgREST
+// Content-Range header such as "0-24/117", returning ok=false for an
+// unknown total ("0-24/*") or a malformed header.
+func parseContentRangeTotal(headerValue string) (int, bool) {
+	_, totalPart, found := strings.Cut(headerValue, "/")
+	if !found || totalPart == "*" {
+		return 0, false
+	}
+	total, err := strconv.Atoi(totalPart)
+	if err != nil {
+		return 0, false
+	}
+	return total, true
+}
+
+// Next advances the iterator and reports whether a row is available via
+// Scan. It returns false at the end of the result set or on error; check
+// Err to distinguish the two.
+func (it *RowIterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.pending) == 0 {
+		if it.done {
+			return false
+		}
+		if err := it.fetchNextPage(); err != nil {
+			if it.ctx.Err() != nil {
+				it.err = it.ctx.Err()
+			} else {
+				it.err = err
+			}
+			return false
+		}
+	}
+
+	var row T
+	if err := json.Unmarshal(it.pending[0], &row); err != nil {
+		it.err = err
+		return false
+	}
+	it.pending = it.pending[1:]
+	it.cur = row
+	return true
+}
+
+// Scan copies the row most recently advanced to by Next into dst.
+func (it *RowIterator[T]) Scan(dst *T) error {
+	if it.err != nil {
+		return it.err
+	}
+	*dst = it.cur
+	return nil
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *RowIterator[T]) Err() error {
+	return it.err
+}
+
+// Close cancels any in-flight request and releases the iterator's context.
+// It is safe to call Close more than once.
+func (it *RowIterator[T]) Close() error {
+	it.cancel()
+	return nil
+}
@@ -0,0 +1,89 @@
+package postgrest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type iterRow struct {
+	ID int `json:"id"`
+}
+
+func newIterateClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewClient(server.URL, map[string]string{})
+}
+
+func TestIteratePagesUntilShortPage(t *testing.T) {
+	var gotRanges []string
+	client := newIterateClient(t, func(w http.ResponseWriter, r *http.Request) {
+		gotRanges = append(gotRanges, r.Header.Get("Range"))
+		w.Header().Set("Content-Range", "0-1/3")
+		switch len(gotRanges) {
+		case 1:
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		default:
+			fmt.Fprint(w, `[{"id":3}]`)
+		}
+	})
+
+	f := client.From("items").Select("*", "", false)
+	it, err := Iterate[iterRow](f, context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Iterate: %v", err)
+	}
+	defer it.Close()
+
+	var ids []int
+	for it.Next() {
+		var row iterRow
+		if err := it.Scan(&row); err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+		ids = append(ids, row.ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+
+	want := []int{1, 2, 3}
+	if len(ids) != len(want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("ids[%d] = %d, want %d", i, ids[i], want[i])
+		}
+	}
+	if len(gotRanges) != 2 {
+		t.Errorf("made %d requests, want 2", len(gotRanges))
+	}
+}
+
+func TestIterateDeniedByAuthorizerYieldsNoRows(t *testing.T) {
+	requests := 0
+	client := newIterateClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `[]`)
+	})
+	client.WithAuthorizer(stubAuthorizer{expr: DenyAll})
+
+	f := client.From("items").Select("*", "", false)
+	it, err := Iterate[iterRow](f, context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Iterate: %v", err)
+	}
+	defer it.Close()
+
+	if it.Next() {
+		t.Errorf("Next() = true, want false for a denied authorizer")
+	}
+	if requests != 0 {
+		t.Errorf("made %d requests to the server, want 0 (denied before the request)", requests)
+	}
+}
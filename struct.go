@@ -0,0 +1,160 @@
+package postgrest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// structTagOptions holds the parsed options from a `postgrest:"..."` struct
+// tag, beyond the leading column/resource name.
+type structTagOptions struct {
+	op         string
+	omitempty  bool
+	selectExpr string
+}
+
+// parseStructTag splits a `postgrest:"column,op=eq,omitempty"` tag into its
+// column/resource name and options.
+func parseStructTag(tag string) (string, structTagOptions) {
+	parts := strings.Split(tag, ",")
+	var opts structTagOptions
+	for _, part := range parts[1:] {
+		switch {
+		case part == "omitempty":
+			opts.omitempty = true
+		case strings.HasPrefix(part, "op="):
+			opts.op = strings.TrimPrefix(part, "op=")
+		case strings.HasPrefix(part, "select="):
+			opts.selectExpr = strings.TrimPrefix(part, "select=")
+		}
+	}
+	return parts[0], opts
+}
+
+// MatchStruct reads v, a struct (or pointer to struct) whose fields carry
+// `postgrest:"column,op=eq"`-style tags, and applies one filter per tagged
+// field - the struct equivalent of calling Eq/Gte/... once per field. A
+// pointer field that is nil is treated as "not set" and skipped regardless
+// of omitempty; omitempty additionally skips zero-valued non-pointer fields.
+// Slice fields render as an in.(...) filter, ignoring any op tag.
+func (f *FilterBuilder) MatchStruct(v any) *FilterBuilder {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		f.client.ClientError = fmt.Errorf("postgrest: MatchStruct requires a struct, got %s", rv.Kind())
+		return f
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("postgrest")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		column, opts := parseStructTag(tag)
+
+		fv := rv.Field(i)
+		wasPtr := fv.Kind() == reflect.Ptr
+		if wasPtr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		// omitempty only skips zero-valued non-pointer fields; a non-nil
+		// pointer is an explicit "set" even if it points at a zero value.
+		if opts.omitempty && !wasPtr && fv.IsZero() {
+			continue
+		}
+
+		if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 {
+			values := make([]string, fv.Len())
+			for j := 0; j < fv.Len(); j++ {
+				value, err := formatFilterValue(fv.Index(j).Interface())
+				if err != nil {
+					f.client.ClientError = err
+					return f
+				}
+				values[j] = value
+			}
+			f.In(column, values)
+			continue
+		}
+
+		op := opts.op
+		if op == "" {
+			op = "eq"
+		}
+		if !isOperator(op) {
+			f.client.ClientError = fmt.Errorf("postgrest: invalid filter operator %q on field %s", op, field.Name)
+			return f
+		}
+		value, err := formatFilterValue(fv.Interface())
+		if err != nil {
+			f.client.ClientError = err
+			return f
+		}
+		f.params[column] = op + "." + value
+	}
+	return f
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// buildSelectFields walks rt's tagged fields, rendering nested structs as
+// embedded-resource selects (e.g. "author(id,name)") and honoring a
+// select=... tag option for computed/virtual columns.
+func buildSelectFields(rt reflect.Type) []string {
+	fields := make([]string, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("postgrest")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		column, opts := parseStructTag(tag)
+
+		ft := field.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+		}
+
+		switch {
+		case ft.Kind() == reflect.Struct && ft != timeType:
+			fields = append(fields, fmt.Sprintf("%s(%s)", column, strings.Join(buildSelectFields(ft), ",")))
+		case opts.selectExpr != "":
+			fields = append(fields, opts.selectExpr)
+		default:
+			fields = append(fields, column)
+		}
+	}
+	return fields
+}
+
+// SelectStruct reads v, a struct (or pointer to struct) whose fields carry
+// `postgrest:"column"`-style tags, and builds a select list from them -
+// nested tagged structs become embedded-resource selects, e.g. a struct with
+// an Author field tagged `postgrest:"author"` whose own Name field is
+// tagged `postgrest:"name"` selects "author(name)".
+func (q *QueryBuilder) SelectStruct(v any) *FilterBuilder {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		q.client.ClientError = fmt.Errorf("postgrest: SelectStruct requires a struct, got %s", rv.Kind())
+		return q.Select("", "", false)
+	}
+	return q.Select(strings.Join(buildSelectFields(rv.Type()), ","), "", false)
+}
@@ -0,0 +1,38 @@
+package postgrest
+
+import "testing"
+
+func TestMatchStructPointerDistinguishesNilFromZero(t *testing.T) {
+	type filter struct {
+		Age *int `postgrest:"age,op=gte,omitempty"`
+	}
+
+	zero := 0
+	f := &FilterBuilder{client: &Client{}, params: map[string]string{}}
+	f.MatchStruct(filter{Age: &zero})
+
+	want := "gte.0"
+	if got := f.params["age"]; got != want {
+		t.Errorf("params[age] = %q, want %q (a non-nil pointer to zero is still set)", got, want)
+	}
+
+	f = &FilterBuilder{client: &Client{}, params: map[string]string{}}
+	f.MatchStruct(filter{Age: nil})
+	if _, ok := f.params["age"]; ok {
+		t.Errorf("params[age] set for nil pointer, want it omitted")
+	}
+}
+
+func TestMatchStructSliceEscapesOnce(t *testing.T) {
+	type filter struct {
+		Tags []string `postgrest:"tags"`
+	}
+
+	f := &FilterBuilder{client: &Client{}, params: map[string]string{}}
+	f.MatchStruct(filter{Tags: []string{"a,b", "c"}})
+
+	want := `in.("a,b",c)`
+	if got := f.params["tags"]; got != want {
+		t.Errorf("params[tags] = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,165 @@
+package postgrest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// formatFilterValue converts v into the PostgREST filter value PostgREST
+// expects, dispatching on its Go type: time.Time is formatted as RFC3339,
+// []byte as base64, bool as true/false, nil as the literal null, numeric
+// kinds via reflect, json.Marshaler as JSON, and fmt.Stringer as a last
+// resort before %v. It does not escape the commas/parens FilterBuilder.In
+// cares about - that's In's job, applied once when it joins a value list,
+// not here on every individual value.
+func formatFilterValue(v any) (string, error) {
+	if v == nil {
+		return "null", nil
+	}
+
+	switch val := v.(type) {
+	case time.Time:
+		return val.Format(time.RFC3339), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(val), nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	case string:
+		return val, nil
+	case json.Marshaler:
+		sum, err := val.MarshalJSON()
+		if err != nil {
+			return "", err
+		}
+		return string(sum), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	}
+
+	if stringer, ok := v.(fmt.Stringer); ok {
+		return stringer.String(), nil
+	}
+
+	return fmt.Sprintf("%v", v), nil
+}
+
+// EqAny, NeqAny, ... are the type-safe counterparts to FilterBuilder's
+// string-only Eq, Neq, ... - they format v according to its Go type
+// (time.Time, []byte, bool, nil, numeric kinds, json.Marshaler,
+// fmt.Stringer) instead of requiring the caller to pre-format it.
+
+func (f *FilterBuilder) EqAny(column string, v any) *FilterBuilder {
+	value, err := formatFilterValue(v)
+	if err != nil {
+		f.client.ClientError = err
+		return f
+	}
+	return f.Eq(column, value)
+}
+
+func (f *FilterBuilder) NeqAny(column string, v any) *FilterBuilder {
+	value, err := formatFilterValue(v)
+	if err != nil {
+		f.client.ClientError = err
+		return f
+	}
+	return f.Neq(column, value)
+}
+
+func (f *FilterBuilder) GtAny(column string, v any) *FilterBuilder {
+	value, err := formatFilterValue(v)
+	if err != nil {
+		f.client.ClientError = err
+		return f
+	}
+	return f.Gt(column, value)
+}
+
+func (f *FilterBuilder) GteAny(column string, v any) *FilterBuilder {
+	value, err := formatFilterValue(v)
+	if err != nil {
+		f.client.ClientError = err
+		return f
+	}
+	return f.Gte(column, value)
+}
+
+func (f *FilterBuilder) LtAny(column string, v any) *FilterBuilder {
+	value, err := formatFilterValue(v)
+	if err != nil {
+		f.client.ClientError = err
+		return f
+	}
+	return f.Lt(column, value)
+}
+
+func (f *FilterBuilder) LteAny(column string, v any) *FilterBuilder {
+	value, err := formatFilterValue(v)
+	if err != nil {
+		f.client.ClientError = err
+		return f
+	}
+	return f.Lte(column, value)
+}
+
+// InAny is the type-safe counterpart to In: each element of vs is formatted
+// according to its Go type, then handed to In as a whole so the
+// comma/paren escaping for the list stays in one place.
+func (f *FilterBuilder) InAny(column string, vs []any) *FilterBuilder {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		value, err := formatFilterValue(v)
+		if err != nil {
+			f.client.ClientError = err
+			return f
+		}
+		values[i] = value
+	}
+	return f.In(column, values)
+}
+
+// IsNull, IsTrue, IsFalse filter column against PostgREST's null/true/false
+// literals, equivalent to Is(column, "null"/"true"/"false").
+
+func (f *FilterBuilder) IsNull(column string) *FilterBuilder  { return f.Is(column, "null") }
+func (f *FilterBuilder) IsTrue(column string) *FilterBuilder  { return f.Is(column, "true") }
+func (f *FilterBuilder) IsFalse(column string) *FilterBuilder { return f.Is(column, "false") }
+
+// EqTime, NeqTime, ... are the time.Time-specific counterparts to Eq, Neq,
+// ..., formatting t as RFC3339.
+
+func (f *FilterBuilder) EqTime(column string, t time.Time) *FilterBuilder {
+	return f.Eq(column, t.Format(time.RFC3339))
+}
+
+func (f *FilterBuilder) NeqTime(column string, t time.Time) *FilterBuilder {
+	return f.Neq(column, t.Format(time.RFC3339))
+}
+
+func (f *FilterBuilder) GtTime(column string, t time.Time) *FilterBuilder {
+	return f.Gt(column, t.Format(time.RFC3339))
+}
+
+func (f *FilterBuilder) GteTime(column string, t time.Time) *FilterBuilder {
+	return f.Gte(column, t.Format(time.RFC3339))
+}
+
+func (f *FilterBuilder) LtTime(column string, t time.Time) *FilterBuilder {
+	return f.Lt(column, t.Format(time.RFC3339))
+}
+
+func (f *FilterBuilder) LteTime(column string, t time.Time) *FilterBuilder {
+	return f.Lte(column, t.Format(time.RFC3339))
+}
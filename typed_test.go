@@ -0,0 +1,23 @@
+package postgrest
+
+import "testing"
+
+func TestFormatFilterValueString(t *testing.T) {
+	value, err := formatFilterValue("a,b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value != "a,b" {
+		t.Errorf("value = %q, want %q (escaping is In's job, not formatFilterValue's)", value, "a,b")
+	}
+}
+
+func TestInAnyEscapesOnce(t *testing.T) {
+	f := &FilterBuilder{client: &Client{}, params: map[string]string{}}
+	f.InAny("tags", []any{"a,b", "c"})
+
+	want := `in.("a,b",c)`
+	if got := f.params["tags"]; got != want {
+		t.Errorf("params[tags] = %q, want %q", got, want)
+	}
+}